@@ -0,0 +1,294 @@
+package opensearchsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchutil"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// BulkItemError is a single failed document out of a bulk request, carrying
+// the same type/reason/doc ID detail OpenSearch reports per item instead of
+// only a flattened failure count.
+type BulkItemError struct {
+	DocumentID string
+	Type       string
+	Reason     string
+}
+
+// BulkResult summarizes a bulk request: how many documents were flushed,
+// how many the bulk indexer reported as failed, and the per-item errors.
+// Errors also holds items that never reached the indexer (marshal or enqueue
+// failures), which are not counted in NumFailed.
+type BulkResult struct {
+	NumFlushed uint64
+	NumFailed  uint64
+	Errors     []BulkItemError
+}
+
+// bulkCollector gathers per-item failures from opensearchutil.BulkIndexer
+// callbacks, which run concurrently across the indexer's internal workers.
+type bulkCollector struct {
+	mu     sync.Mutex
+	errors []BulkItemError
+}
+
+func (c *bulkCollector) addError(docID, errType, reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.errors = append(c.errors, BulkItemError{DocumentID: docID, Type: errType, Reason: reason})
+}
+
+func (r *openSearchRepository[T]) InsertBulk(indexName string, contentList []T) (BulkResult, error) {
+	if !r.IsAvailable() {
+		return BulkResult{}, ErrClusterUnavailable
+	}
+
+	indexer, err := opensearchutil.NewBulkIndexer(opensearchutil.BulkIndexerConfig{
+		Client: r.opensearchClient,
+		Index:  indexName,
+	})
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	collector := &bulkCollector{}
+	for _, content := range contentList {
+		docContent, err := json.Marshal(content.ToDoc())
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+				zap.String("doc_id", content.GetID()),
+			)
+			collector.addError(content.GetID(), "marshal_error", err.Error())
+			continue
+		}
+
+		r.addBulkItem(indexer, collector, indexName, "index", content.GetID(), bytes.NewReader(docContent))
+	}
+
+	return r.closeBulkIndexer(indexer, indexName, collector)
+}
+
+func (r *openSearchRepository[T]) UpdateBulk(indexName string, updates map[string]map[string]interface{}) (BulkResult, error) {
+	if !r.IsAvailable() {
+		return BulkResult{}, ErrClusterUnavailable
+	}
+
+	indexer, err := opensearchutil.NewBulkIndexer(opensearchutil.BulkIndexerConfig{
+		Client: r.opensearchClient,
+		Index:  indexName,
+	})
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	collector := &bulkCollector{}
+	for docID, doc := range updates {
+		docContent, err := json.Marshal(map[string]interface{}{"doc": doc})
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+			)
+			collector.addError(docID, "marshal_error", err.Error())
+			continue
+		}
+
+		r.addBulkItem(indexer, collector, indexName, "update", docID, bytes.NewReader(docContent))
+	}
+
+	return r.closeBulkIndexer(indexer, indexName, collector)
+}
+
+func (r *openSearchRepository[T]) DeleteBulk(indexName string, ids []string) (BulkResult, error) {
+	if !r.IsAvailable() {
+		return BulkResult{}, ErrClusterUnavailable
+	}
+
+	indexer, err := opensearchutil.NewBulkIndexer(opensearchutil.BulkIndexerConfig{
+		Client: r.opensearchClient,
+		Index:  indexName,
+	})
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	collector := &bulkCollector{}
+	for _, docID := range ids {
+		r.addBulkItem(indexer, collector, indexName, "delete", docID, nil)
+	}
+
+	return r.closeBulkIndexer(indexer, indexName, collector)
+}
+
+// addBulkItem enqueues a single item on indexer. RepositoryConfig.RequestTimeout
+// and MaxRetries do not apply here: opensearchutil.BulkIndexer flushes queued
+// items on its own internal worker goroutines, using their own context, which
+// this SDK does not control per item, so there is no per-item deadline or
+// retry to configure. Per-item failures are reported via BulkResult.Errors
+// instead of being retried.
+func (r *openSearchRepository[T]) addBulkItem(indexer opensearchutil.BulkIndexer, collector *bulkCollector, indexName, action, docID string, body *bytes.Reader) {
+	item := opensearchutil.BulkIndexerItem{
+		Index:      indexName,
+		Action:     action,
+		DocumentID: docID,
+		OnSuccess: func(
+			ctx context.Context,
+			item opensearchutil.BulkIndexerItem,
+			res opensearchutil.BulkIndexerResponseItem) {
+			r.logger.Info(
+				"successfully "+action+" doc content",
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+			)
+		},
+		OnFailure: func(
+			ctx context.Context,
+			item opensearchutil.BulkIndexerItem,
+			res opensearchutil.BulkIndexerResponseItem,
+			err error) {
+			if err != nil {
+				r.logger.Error(
+					err.Error(),
+					zap.Error(err),
+					zap.String("index_name", indexName),
+					zap.String("doc_id", docID),
+				)
+				collector.addError(docID, "request_error", err.Error())
+			} else {
+				r.logger.Error(
+					res.Error.Reason,
+					zap.String("index_name", indexName),
+					zap.String("doc_id", docID),
+					zap.String("error_type", res.Error.Type),
+					zap.String("error_reason", res.Error.Reason),
+				)
+				collector.addError(docID, res.Error.Type, res.Error.Reason)
+			}
+		},
+	}
+	if body != nil {
+		item.Body = body
+	}
+
+	if err := indexer.Add(context.Background(), item); err != nil {
+		r.logger.Error(
+			err.Error(),
+			zap.Error(err),
+			zap.String("index_name", indexName),
+			zap.String("doc_id", docID),
+		)
+		collector.addError(docID, "enqueue_error", err.Error())
+	}
+}
+
+func (r *openSearchRepository[T]) closeBulkIndexer(indexer opensearchutil.BulkIndexer, indexName string, collector *bulkCollector) (BulkResult, error) {
+	if err := indexer.Close(context.Background()); err != nil {
+		r.logger.Error(
+			err.Error(),
+			zap.Error(err),
+			zap.String("index_name", indexName),
+		)
+	}
+
+	stats := indexer.Stats()
+	result := BulkResult{
+		NumFlushed: stats.NumFlushed,
+		NumFailed:  stats.NumFailed,
+		Errors:     collector.errors,
+	}
+
+	if stats.NumFailed > 0 {
+		r.logger.Error(
+			"bulk request had failed documents",
+			zap.Error(errors.New("bulk request had failed documents")),
+			zap.String("index_name", indexName),
+			zap.Uint64("num_flush", stats.NumFlushed),
+			zap.Uint64("num_failed", stats.NumFailed),
+		)
+	} else {
+		r.logger.Info(
+			"successfully processed bulk request",
+			zap.String("index_name", indexName),
+		)
+	}
+
+	return result, nil
+}
+
+// BulkSession is a streaming bulk request: items are fed one at a time via
+// Index/Update/Delete so callers can drain a channel or loop without
+// materializing the full batch in memory, then finalized with Close.
+type BulkSession[T OpenSearchDocumentAble] interface {
+	Index(doc T) error
+	Update(id string, doc map[string]interface{}) error
+	Delete(id string) error
+	Close() (BulkResult, error)
+}
+
+type bulkSession[T OpenSearchDocumentAble] struct {
+	repo      *openSearchRepository[T]
+	indexer   opensearchutil.BulkIndexer
+	indexName string
+	collector *bulkCollector
+}
+
+func (r *openSearchRepository[T]) BulkStream(indexName string) (BulkSession[T], error) {
+	if !r.IsAvailable() {
+		return nil, ErrClusterUnavailable
+	}
+
+	indexer, err := opensearchutil.NewBulkIndexer(opensearchutil.BulkIndexerConfig{
+		Client: r.opensearchClient,
+		Index:  indexName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bulkSession[T]{
+		repo:      r,
+		indexer:   indexer,
+		indexName: indexName,
+		collector: &bulkCollector{},
+	}, nil
+}
+
+func (s *bulkSession[T]) Index(doc T) error {
+	docContent, err := json.Marshal(doc.ToDoc())
+	if err != nil {
+		return err
+	}
+
+	s.repo.addBulkItem(s.indexer, s.collector, s.indexName, "index", doc.GetID(), bytes.NewReader(docContent))
+	return nil
+}
+
+func (s *bulkSession[T]) Update(id string, doc map[string]interface{}) error {
+	docContent, err := json.Marshal(map[string]interface{}{"doc": doc})
+	if err != nil {
+		return err
+	}
+
+	s.repo.addBulkItem(s.indexer, s.collector, s.indexName, "update", id, bytes.NewReader(docContent))
+	return nil
+}
+
+func (s *bulkSession[T]) Delete(id string) error {
+	s.repo.addBulkItem(s.indexer, s.collector, s.indexName, "delete", id, nil)
+	return nil
+}
+
+func (s *bulkSession[T]) Close() (BulkResult, error) {
+	return s.repo.closeBulkIndexer(s.indexer, s.indexName, s.collector)
+}
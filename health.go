@@ -0,0 +1,79 @@
+package opensearchsdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// DefaultPingInterval is the interval used by NewOpenSearchRepository to poll
+// cluster availability when no explicit interval is given.
+const DefaultPingInterval = 10 * time.Second
+
+// ErrClusterUnavailable is returned by OpenSearchRepository methods when the
+// background health check has marked the cluster as down, instead of letting
+// the call block until its own request timeout elapses.
+var ErrClusterUnavailable = errors.New("opensearch cluster is unavailable")
+
+// startHealthCheck pings the cluster once synchronously so IsAvailable is
+// accurate as soon as the repository is constructed, then keeps refreshing it
+// on a ticker until Close is called.
+func (r *openSearchRepository[T]) startHealthCheck() {
+	r.checkAvailability()
+
+	go func() {
+		ticker := time.NewTicker(r.config.PingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.checkAvailability()
+			case <-r.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (r *openSearchRepository[T]) checkAvailability() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.opensearchClient.Ping(r.opensearchClient.Ping.WithContext(ctx))
+
+	available := err == nil && !res.IsError()
+	if res != nil {
+		defer res.Body.Close()
+	}
+
+	r.availabilityMu.Lock()
+	r.available = available
+	r.availabilityMu.Unlock()
+
+	if !available {
+		r.logger.Error(
+			"opensearch cluster is unavailable",
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *openSearchRepository[T]) IsAvailable() bool {
+	r.availabilityMu.RLock()
+	defer r.availabilityMu.RUnlock()
+
+	return r.available
+}
+
+// Close stops the background availability checker. It is safe to call more
+// than once and should be invoked as part of graceful shutdown.
+func (r *openSearchRepository[T]) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.stopCh)
+	})
+
+	return nil
+}
@@ -0,0 +1,365 @@
+package opensearchsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// IndexExists reports whether name exists as an index or alias.
+func (r *openSearchRepository[T]) IndexExists(name string) (bool, error) {
+	if !r.IsAvailable() {
+		return false, ErrClusterUnavailable
+	}
+
+	var exists bool
+	err := r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.Exists(
+			[]string{name},
+			r.opensearchClient.Indices.Exists.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", name),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		switch res.StatusCode {
+		case http.StatusOK:
+			exists = true
+			return res.StatusCode, nil
+		case http.StatusNotFound:
+			exists = false
+			return res.StatusCode, nil
+		default:
+			return res.StatusCode, errors.New(res.String())
+		}
+	})
+
+	return exists, err
+}
+
+// EnsureIndex creates the index with indexBody only if it does not already
+// exist, letting callers run index setup unconditionally on startup. Unlike
+// CreateIndex, the actual create request is given the same
+// RequestTimeout/retry treatment as the rest of this file so a slow or
+// partially unavailable cluster can't block it indefinitely.
+func (r *openSearchRepository[T]) EnsureIndex(name string, indexBody []byte) error {
+	exists, err := r.IndexExists(name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.Create(
+			name,
+			r.opensearchClient.Indices.Create.WithContext(ctx),
+			r.opensearchClient.Indices.Create.WithBody(bytes.NewReader(indexBody)),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", name),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+// DeleteIndex deletes an index, failing if it does not exist.
+func (r *openSearchRepository[T]) DeleteIndex(name string) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.Delete(
+			[]string{name},
+			r.opensearchClient.Indices.Delete.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", name),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+// PutIndexTemplate creates or updates an index template, used to set the
+// mappings/settings that new indices created for a reindex migration should
+// pick up automatically.
+func (r *openSearchRepository[T]) PutIndexTemplate(name string, body []byte) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.PutIndexTemplate(
+			name,
+			bytes.NewReader(body),
+			r.opensearchClient.Indices.PutIndexTemplate.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("template_name", name),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+// PutAlias points alias at index, in addition to any indices it already
+// points to.
+func (r *openSearchRepository[T]) PutAlias(index, alias string) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.PutAlias(
+			[]string{index},
+			alias,
+			r.opensearchClient.Indices.PutAlias.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", index),
+				zap.String("alias", alias),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+// SwitchAlias atomically moves alias from oldIndex to newIndex using a
+// single Update Aliases request, so readers never observe the alias
+// resolving to neither index, the standard way to flip traffic at the end
+// of a zero-downtime reindex migration.
+func (r *openSearchRepository[T]) SwitchAlias(alias, oldIndex, newIndex string) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	body := map[string]interface{}{
+		"actions": []map[string]interface{}{
+			{"remove": map[string]interface{}{"index": oldIndex, "alias": alias}},
+			{"add": map[string]interface{}{"index": newIndex, "alias": alias}},
+		},
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Indices.UpdateAliases(
+			bytes.NewReader(bodyJSON),
+			r.opensearchClient.Indices.UpdateAliases.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("alias", alias),
+				zap.String("old_index", oldIndex),
+				zap.String("new_index", newIndex),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+// Reindex starts an asynchronous reindex from source to dest, optionally
+// scoped by query, and returns the task ID to poll with WaitReindex.
+func (r *openSearchRepository[T]) Reindex(source, dest string, query map[string]interface{}) (string, error) {
+	if !r.IsAvailable() {
+		return "", ErrClusterUnavailable
+	}
+
+	sourceBody := map[string]interface{}{"index": source}
+	if query != nil {
+		sourceBody["query"] = query
+	}
+
+	bodyJSON, err := json.Marshal(map[string]interface{}{
+		"source": sourceBody,
+		"dest":   map[string]interface{}{"index": dest},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var taskID string
+	err = r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Reindex(
+			bytes.NewReader(bodyJSON),
+			r.opensearchClient.Reindex.WithContext(ctx),
+			r.opensearchClient.Reindex.WithWaitForCompletion(false),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("source_index", source),
+				zap.String("dest_index", dest),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		var decoded struct {
+			Task string `json:"task"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&decoded); err != nil {
+			return res.StatusCode, err
+		}
+
+		taskID = decoded.Task
+		return res.StatusCode, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return taskID, nil
+}
+
+// WaitReindex polls the Tasks API every poll interval until taskID completes,
+// returning an error if the task itself failed or the repository is closed
+// while waiting.
+func (r *openSearchRepository[T]) WaitReindex(taskID string, poll time.Duration) error {
+	for {
+		if !r.IsAvailable() {
+			return ErrClusterUnavailable
+		}
+
+		completed, taskErr, err := r.pollReindexTask(taskID)
+		if err != nil {
+			return err
+		}
+		if completed {
+			return taskErr
+		}
+
+		timer := time.NewTimer(poll)
+		select {
+		case <-timer.C:
+		case <-r.stopCh:
+			timer.Stop()
+			return errors.Errorf("repository closed while waiting for reindex task %s", taskID)
+		}
+	}
+}
+
+func (r *openSearchRepository[T]) pollReindexTask(taskID string) (completed bool, taskErr error, err error) {
+	var decoded struct {
+		Completed bool `json:"completed"`
+		Error     *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}
+
+	err = r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Tasks.Get(
+			taskID,
+			r.opensearchClient.Tasks.Get.WithContext(ctx),
+		)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("task_id", taskID),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		if err := json.NewDecoder(res.Body).Decode(&decoded); err != nil {
+			return res.StatusCode, err
+		}
+
+		return res.StatusCode, nil
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if !decoded.Completed {
+		return false, nil, nil
+	}
+
+	if decoded.Error != nil {
+		return true, errors.Errorf("reindex task %s failed: %s", taskID, decoded.Error.Reason), nil
+	}
+
+	return true, nil, nil
+}
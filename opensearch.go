@@ -11,8 +11,8 @@ import (
 	gosdk "github.com/thinc-org/newbie-gosdk"
 	"github.com/thinc-org/newbie-repository"
 	"go.uber.org/zap"
-	"log"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -29,25 +29,71 @@ type OpenSearchDocumentAble interface {
 type OpenSearchRepository[T OpenSearchDocumentAble] interface {
 	CreateIndex(indexName string, indexBody []byte) error
 	Insert(indexName string, docID string, doc T) error
-	InsertBulk(indexName string, contentList []T) error
+	InsertBulk(indexName string, contentList []T) (BulkResult, error)
+	UpdateBulk(indexName string, updates map[string]map[string]interface{}) (BulkResult, error)
+	DeleteBulk(indexName string, ids []string) (BulkResult, error)
+	BulkStream(indexName string) (BulkSession[T], error)
 	Update(indexName string, docID string, doc map[string]interface{}) error
 	Delete(indexName string, docID string) error
-	Search(indexName string, req *map[string]interface{}, result *map[string]interface{}, meta *repositorysdk.PaginationMetadata) error
+	Search(indexName string, opts *SearchOptions, result *SearchResult[T], meta *repositorysdk.PaginationMetadata) error
+	SearchRaw(indexName string, req *map[string]interface{}, result *map[string]interface{}, meta *repositorysdk.PaginationMetadata) error
 	Suggest(indexName string, req *map[string]interface{}, result *map[string]interface{}) error
+	Scroll(indexName string, req *map[string]interface{}, keepAlive time.Duration) (ScrollCursor[T], error)
+	SearchAfter(indexName string, req *map[string]interface{}, sort []interface{}, pageSize int) (Cursor[T], error)
+	IndexExists(name string) (bool, error)
+	EnsureIndex(name string, body []byte) error
+	DeleteIndex(name string) error
+	PutIndexTemplate(name string, body []byte) error
+	PutAlias(index, alias string) error
+	SwitchAlias(alias, oldIndex, newIndex string) error
+	Reindex(source, dest string, query map[string]interface{}) (taskID string, err error)
+	WaitReindex(taskID string, poll time.Duration) error
+	IsAvailable() bool
+	Close() error
 }
 
 type openSearchRepository[T OpenSearchDocumentAble] struct {
 	opensearchClient *opensearch.Client
 	logger           *zap.Logger
+	config           RepositoryConfig
+
+	availabilityMu sync.RWMutex
+	available      bool
+	stopCh         chan struct{}
+	closeOnce      sync.Once
 }
 
 func NewOpenSearchRepository[T OpenSearchDocumentAble](client *opensearch.Client) OpenSearchRepository[T] {
+	return NewOpenSearchRepositoryWithConfig[T](client, DefaultRepositoryConfig())
+}
+
+// NewOpenSearchRepositoryWithOptions behaves like NewOpenSearchRepository but lets
+// callers configure how often the background health check pings the cluster.
+func NewOpenSearchRepositoryWithOptions[T OpenSearchDocumentAble](client *opensearch.Client, pingInterval time.Duration) OpenSearchRepository[T] {
+	config := DefaultRepositoryConfig()
+	config.PingInterval = pingInterval
+
+	return NewOpenSearchRepositoryWithConfig[T](client, config)
+}
+
+// NewOpenSearchRepositoryWithConfig behaves like NewOpenSearchRepository but
+// lets callers override request timeouts, retry behavior and the health
+// check interval via RepositoryConfig. Zero-valued fields fall back to the
+// defaults from DefaultRepositoryConfig.
+func NewOpenSearchRepositoryWithConfig[T OpenSearchDocumentAble](client *opensearch.Client, config RepositoryConfig) OpenSearchRepository[T] {
 	logger, _ := gosdk.NewLogger()
 
-	return &openSearchRepository[T]{
+	r := &openSearchRepository[T]{
 		opensearchClient: client,
 		logger:           logger,
+		config:           config.withDefaults(),
+		available:        true,
+		stopCh:           make(chan struct{}),
 	}
+
+	r.startHealthCheck()
+
+	return r
 }
 
 func (r *openSearchRepository[T]) CreateIndex(indexName string, indexBody []byte) error {
@@ -66,31 +112,38 @@ func (r *openSearchRepository[T]) CreateIndex(indexName string, indexBody []byte
 	return nil
 }
 
-func (r *openSearchRepository[T]) Search(indexName string, req *map[string]interface{}, result *map[string]interface{}, meta *repositorysdk.PaginationMetadata) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	(*req)["from"] = meta.GetOffset()
-	(*req)["size"] = meta.GetItemPerPage()
+// Search runs a typed query built from SearchOptions and decodes the hits
+// into result.Hits, leaving callers to construct the OpenSearch query DSL by
+// hand only for cases SearchOptions does not yet cover (see SearchRaw).
+func (r *openSearchRepository[T]) Search(indexName string, opts *SearchOptions, result *SearchResult[T], meta *repositorysdk.PaginationMetadata) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
 
-	reqJSON, err := json.Marshal(req)
+	req, err := opts.toQuery()
 	if err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-		)
+		return err
+	}
 
+	raw := map[string]interface{}{}
+	if err := r.SearchRaw(indexName, &req, &raw, meta); err != nil {
 		return err
 	}
 
-	search := opensearchapi.SearchRequest{
-		Index: []string{indexName},
-		Body:  bytes.NewReader(reqJSON),
+	return decodeSearchResult(raw, result)
+}
+
+// SearchRaw is the original map-based search kept for callers that already
+// hand-build the OpenSearch query DSL.
+func (r *openSearchRepository[T]) SearchRaw(indexName string, req *map[string]interface{}, result *map[string]interface{}, meta *repositorysdk.PaginationMetadata) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
 	}
 
-	res, err := search.Do(ctx, r.opensearchClient)
+	(*req)["from"] = meta.GetOffset()
+	(*req)["size"] = meta.GetItemPerPage()
 
+	reqJSON, err := json.Marshal(req)
 	if err != nil {
 		r.logger.Error(
 			err.Error(),
@@ -101,18 +154,41 @@ func (r *openSearchRepository[T]) Search(indexName string, req *map[string]inter
 		return err
 	}
 
-	if res.StatusCode > 200 {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-		)
-
-		return errors.New("Invalid query")
-	}
-	defer res.Body.Close()
-
-	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+	err = r.doWithRetry(func(ctx context.Context) (int, error) {
+		search := opensearchapi.SearchRequest{
+			Index: []string{indexName},
+			Body:  bytes.NewReader(reqJSON),
+		}
+
+		res, err := search.Do(ctx, r.opensearchClient)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+			)
+
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode > 200 {
+			r.logger.Error(
+				"Invalid query",
+				zap.String("index_name", indexName),
+				zap.Int("status_code", res.StatusCode),
+			)
+
+			return res.StatusCode, errors.New("Invalid query")
+		}
+
+		if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+			return res.StatusCode, err
+		}
+
+		return res.StatusCode, nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -122,8 +198,9 @@ func (r *openSearchRepository[T]) Search(indexName string, req *map[string]inter
 }
 
 func (r *openSearchRepository[T]) Suggest(indexName string, req *map[string]interface{}, result *map[string]interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
+	}
 
 	// set maximum suggestion = 10
 	(*req)["size"] = 10
@@ -139,266 +216,173 @@ func (r *openSearchRepository[T]) Suggest(indexName string, req *map[string]inte
 		return err
 	}
 
-	search := opensearchapi.SearchRequest{
-		Index: []string{indexName},
-		Body:  bytes.NewReader(reqJSON),
-	}
-
-	res, err := search.Do(ctx, r.opensearchClient)
-	if err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-		)
-
-		return err
-	}
-
-	if res.StatusCode > 200 {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-		)
-
-		return errors.New("Invalid query")
-	}
-	defer res.Body.Close()
-
-	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-		)
-		return err
-	}
-
-	return nil
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		search := opensearchapi.SearchRequest{
+			Index: []string{indexName},
+			Body:  bytes.NewReader(reqJSON),
+		}
+
+		res, err := search.Do(ctx, r.opensearchClient)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+			)
+
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode > 200 {
+			r.logger.Error(
+				"Invalid query",
+				zap.String("index_name", indexName),
+				zap.Int("status_code", res.StatusCode),
+			)
+
+			return res.StatusCode, errors.New("Invalid query")
+		}
+
+		if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+			)
+			return res.StatusCode, err
+		}
+
+		return res.StatusCode, nil
+	})
 }
 
 func (r *openSearchRepository[T]) Insert(indexName string, docID string, doc T) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	req := opensearchapi.IndexRequest{
-		Index:      indexName,
-		DocumentID: docID,
-		Body:       opensearchutil.NewJSONReader(doc.ToDoc()),
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
 	}
 
-	res, err := req.Do(ctx, r.opensearchClient)
-	if err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-			zap.String("doc_id", docID),
-		)
-		return err
-	}
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		req := opensearchapi.IndexRequest{
+			Index:      indexName,
+			DocumentID: docID,
+			Body:       opensearchutil.NewJSONReader(doc.ToDoc()),
+		}
+
+		res, err := req.Do(ctx, r.opensearchClient)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+			)
+			return 0, err
+		}
+
+		if res.StatusCode >= http.StatusBadRequest {
+			r.logger.Error(
+				"insert failed",
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+				zap.Int("status_code", res.StatusCode),
+			)
+			return res.StatusCode, errors.New("insert failed")
+		}
 
-	if res.StatusCode >= http.StatusBadRequest {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
+		r.logger.Info(
+			"successfully insert document",
 			zap.String("index_name", indexName),
 			zap.String("doc_id", docID),
-			zap.Int("status_code", res.StatusCode),
 		)
-		return errors.New("insert failed")
-	}
-
-	r.logger.Info(
-		"successfully insert document",
-		zap.String("index_name", indexName),
-		zap.String("doc_id", docID),
-	)
-
-	return nil
-}
 
-func (r *openSearchRepository[T]) InsertBulk(indexName string, contentList []T) error {
-	// Initialize indexer
-	indexer, err := opensearchutil.NewBulkIndexer(opensearchutil.BulkIndexerConfig{
-		Client: r.opensearchClient,
-		Index:  indexName,
+		return res.StatusCode, nil
 	})
-	if err != nil {
-		log.Fatalf("Error creating the indexer: %s", err)
-	}
+}
 
-	for _, content := range contentList {
-		insertBulk(indexer, r.logger, indexName, content)
+func (r *openSearchRepository[T]) Update(indexName string, docID string, doc map[string]interface{}) error {
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
 	}
 
-	// Close the indexer channel and flush remaining items
-	if err := indexer.Close(context.Background()); err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		res, err := r.opensearchClient.Update(
+			indexName,
+			docID,
+			opensearchutil.NewJSONReader(map[string]interface{}{"doc": doc}),
+			r.opensearchClient.Update.WithContext(ctx),
+			r.opensearchClient.Update.WithTimeout(r.config.RequestTimeout),
 		)
-	}
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+			)
+			return 0, err
+		}
+
+		if res.StatusCode >= http.StatusBadRequest {
+			r.logger.Error(
+				"update failed",
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+				zap.Int("status_code", res.StatusCode),
+			)
+
+			return res.StatusCode, errors.New("update failed")
+		}
 
-	// Report the indexer statistics
-	stats := indexer.Stats()
-	if stats.NumFailed > 0 {
-		r.logger.Error(
-			"inserting some document failed",
-			zap.Error(errors.New("inserting some document failed")),
-			zap.String("index_name", indexName),
-			zap.Uint64("num_flush", stats.NumFlushed),
-			zap.Uint64("num_failed", stats.NumFailed),
-		)
-	} else {
 		r.logger.Info(
-			"successfully insert bulk document",
-			zap.String("index_name", indexName),
-		)
-	}
-
-	return nil
-}
-
-func (r *openSearchRepository[T]) Update(indexName string, docID string, doc map[string]interface{}) error {
-	res, err := r.opensearchClient.Update(indexName, docID, opensearchutil.NewJSONReader(map[string]interface{}{"doc": doc}), r.opensearchClient.Update.WithTimeout(5*time.Second))
-	if err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-			zap.String("doc_id", docID),
-		)
-		return err
-	}
-
-	if res.StatusCode >= http.StatusBadRequest {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
+			"successfully update document",
 			zap.String("index_name", indexName),
 			zap.String("doc_id", docID),
-			zap.Int("status_code", res.StatusCode),
 		)
 
-		return errors.New("update failed")
-	}
-
-	r.logger.Info(
-		"successfully update document",
-		zap.String("index_name", indexName),
-		zap.String("doc_id", docID),
-	)
-
-	return nil
+		return res.StatusCode, nil
+	})
 }
 
 func (r *openSearchRepository[T]) Delete(indexName string, docID string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	req := opensearchapi.DeleteRequest{
-		Index:      indexName,
-		DocumentID: docID,
+	if !r.IsAvailable() {
+		return ErrClusterUnavailable
 	}
 
-	res, err := req.Do(ctx, r.opensearchClient)
-	if err != nil {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-			zap.String("doc_id", docID),
-		)
-		return err
-	}
+	return r.doWithRetry(func(ctx context.Context) (int, error) {
+		req := opensearchapi.DeleteRequest{
+			Index:      indexName,
+			DocumentID: docID,
+		}
+
+		res, err := req.Do(ctx, r.opensearchClient)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+			)
+			return 0, err
+		}
+
+		if res.StatusCode >= http.StatusBadRequest {
+			r.logger.Error(
+				"delete failed",
+				zap.String("index_name", indexName),
+				zap.String("doc_id", docID),
+				zap.Int("status_code", res.StatusCode),
+			)
+			return res.StatusCode, errors.New("delete failed")
+		}
 
-	if res.StatusCode >= http.StatusBadRequest {
-		r.logger.Error(
-			err.Error(),
-			zap.Error(err),
+		r.logger.Info(
+			"successfully delete document",
 			zap.String("index_name", indexName),
 			zap.String("doc_id", docID),
-			zap.Int("status_code", res.StatusCode),
-		)
-		return errors.New("delete failed")
-	}
-
-	r.logger.Info(
-		"successfully delete document",
-		zap.Error(err),
-		zap.String("index_name", indexName),
-		zap.String("doc_id", docID),
-	)
-	return nil
-}
-
-func insertBulk[T OpenSearchDocumentAble](indexer opensearchutil.BulkIndexer, logger *zap.Logger, indexName string, content T) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	docContent, err := json.Marshal(content.ToDoc())
-	if err != nil {
-		logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-			zap.String("doc_id", content.GetID()),
-		)
-	}
-
-	if err := indexer.Add(
-		ctx,
-		opensearchutil.BulkIndexerItem{
-			Index:      indexName,
-			Action:     "index",
-			DocumentID: content.GetID(),
-			Body:       bytes.NewReader(docContent),
-			OnSuccess: func(
-				ctx context.Context,
-				item opensearchutil.BulkIndexerItem,
-				res opensearchutil.BulkIndexerResponseItem) {
-				logger.Info(
-					"successfully insert doc content",
-					zap.Error(err),
-					zap.String("index_name", indexName),
-					zap.String("doc_id", content.GetID()),
-				)
-			},
-			OnFailure: func(
-				ctx context.Context,
-				item opensearchutil.BulkIndexerItem,
-				res opensearchutil.BulkIndexerResponseItem,
-				err error) {
-				if err != nil {
-					logger.Error(
-						err.Error(),
-						zap.Error(err),
-						zap.String("index_name", indexName),
-						zap.String("doc_id", content.GetID()),
-					)
-				} else {
-					logger.Error(
-						err.Error(),
-						zap.Error(err),
-						zap.String("index_name", indexName),
-						zap.String("doc_id", content.GetID()),
-						zap.String("error_type", res.Error.Type),
-						zap.String("error_reason", res.Error.Reason),
-					)
-				}
-			},
-		},
-	); err != nil {
-		logger.Error(
-			err.Error(),
-			zap.Error(err),
-			zap.String("index_name", indexName),
-			zap.String("doc_id", content.GetID()),
 		)
-	}
+		return res.StatusCode, nil
+	})
 }
 
 func calMetadata(meta *repositorysdk.PaginationMetadata, result *map[string]interface{}) {
@@ -0,0 +1,152 @@
+package opensearchsdk
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// DefaultRequestTimeout is the per-attempt deadline used when no
+// RepositoryConfig.RequestTimeout is set.
+const DefaultRequestTimeout = 5 * time.Second
+
+// DefaultMaxRetries is the number of retries attempted after the initial
+// request when no RepositoryConfig.MaxRetries is set.
+const DefaultMaxRetries = 3
+
+const (
+	defaultBackoffBase = 200 * time.Millisecond
+	defaultBackoffCap  = 5 * time.Second
+)
+
+// Backoff computes how long to wait before the attempt-th retry (0-indexed,
+// so attempt 0 is the wait before the first retry).
+type Backoff interface {
+	Wait(attempt int) time.Duration
+}
+
+type exponentialBackoff struct {
+	base time.Duration
+	cap  time.Duration
+}
+
+// NewExponentialBackoff returns a Backoff that waits min(cap, base*2^attempt)
+// plus a random jitter in [0, base], so concurrent callers retrying after the
+// same failure don't all wake up at once.
+func NewExponentialBackoff(base, cap time.Duration) Backoff {
+	return &exponentialBackoff{base: base, cap: cap}
+}
+
+func (b *exponentialBackoff) Wait(attempt int) time.Duration {
+	wait := b.cap
+	if shifted := b.base << attempt; shifted > 0 && shifted < b.cap {
+		wait = shifted
+	}
+
+	return wait + time.Duration(rand.Int63n(int64(b.base)+1))
+}
+
+// RetryOn decides whether a request should be retried given the HTTP status
+// code it returned (0 if the request never reached the server) and the error
+// the attempt failed with (a transport error when the status is 0).
+type RetryOn func(status int, err error) bool
+
+// DefaultRetryOn retries on network errors and on 429/5xx responses, the
+// cases that are safe to retry for the idempotent operations this SDK wraps.
+func DefaultRetryOn(status int, err error) bool {
+	if status == 0 {
+		return err != nil
+	}
+
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// RepositoryConfig configures request timeouts and retry behavior for an
+// OpenSearchRepository. MaxRetries is a pointer so that an explicit
+// MaxRetries: IntPtr(0) (no retries) can be told apart from a zero-valued,
+// unset RepositoryConfig{} (use DefaultMaxRetries); use IntPtr to build one.
+type RepositoryConfig struct {
+	PingInterval   time.Duration
+	RequestTimeout time.Duration
+	MaxRetries     *int
+	RetryOn        RetryOn
+	Backoff        Backoff
+}
+
+// IntPtr returns a pointer to n, for populating RepositoryConfig.MaxRetries.
+func IntPtr(n int) *int {
+	return &n
+}
+
+// DefaultRepositoryConfig returns the RepositoryConfig used by
+// NewOpenSearchRepository.
+func DefaultRepositoryConfig() RepositoryConfig {
+	return RepositoryConfig{
+		PingInterval:   DefaultPingInterval,
+		RequestTimeout: DefaultRequestTimeout,
+		MaxRetries:     IntPtr(DefaultMaxRetries),
+		RetryOn:        DefaultRetryOn,
+		Backoff:        NewExponentialBackoff(defaultBackoffBase, defaultBackoffCap),
+	}
+}
+
+func (c RepositoryConfig) withDefaults() RepositoryConfig {
+	if c.PingInterval <= 0 {
+		c.PingInterval = DefaultPingInterval
+	}
+	if c.RequestTimeout <= 0 {
+		c.RequestTimeout = DefaultRequestTimeout
+	}
+	if c.MaxRetries == nil {
+		c.MaxRetries = IntPtr(DefaultMaxRetries)
+	}
+	if c.RetryOn == nil {
+		c.RetryOn = DefaultRetryOn
+	}
+	if c.Backoff == nil {
+		c.Backoff = NewExponentialBackoff(defaultBackoffBase, defaultBackoffCap)
+	}
+
+	return c
+}
+
+// doWithRetry runs fn, giving it a fresh context.Context bounded by
+// r.config.RequestTimeout on every attempt. fn must return a nil error on
+// success; on failure it returns the HTTP status code it observed (0 if the
+// request never reached the server) alongside the error to report, which
+// RetryOn uses to decide whether to retry. Waiting between attempts is
+// interrupted if the repository is closed.
+func (r *openSearchRepository[T]) doWithRetry(fn func(ctx context.Context) (statusCode int, err error)) error {
+	return r.doWithRetryCtx(context.Background(), fn)
+}
+
+// doWithRetryCtx behaves like doWithRetry but derives each attempt's deadline
+// from a caller-supplied ctx instead of context.Background(), for callers
+// (such as ScrollCursor/Cursor) whose public API already accepts a ctx.
+func (r *openSearchRepository[T]) doWithRetryCtx(ctx context.Context, fn func(ctx context.Context) (statusCode int, err error)) error {
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, r.config.RequestTimeout)
+		statusCode, err := fn(attemptCtx)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if attempt >= *r.config.MaxRetries || !r.config.RetryOn(statusCode, err) {
+			return lastErr
+		}
+
+		timer := time.NewTimer(r.config.Backoff.Wait(attempt))
+		select {
+		case <-timer.C:
+		case <-r.stopCh:
+			timer.Stop()
+			return lastErr
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package opensearchsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ScrollCursor iterates over a scroll search in fixed-size batches. Next
+// returns an empty, nil-error slice once the scroll is exhausted; callers
+// must call Close to release the scroll context on the cluster once done.
+type ScrollCursor[T OpenSearchDocumentAble] interface {
+	Next(ctx context.Context) ([]T, error)
+	Close() error
+}
+
+// Cursor iterates over a search_after search in fixed-size pages. No
+// point-in-time is opened, so pages reflect the index as it is at each Next.
+// Next returns an empty, nil-error slice once there are no more pages.
+type Cursor[T OpenSearchDocumentAble] interface {
+	Next(ctx context.Context) ([]T, error)
+	Close() error
+}
+
+type scrollCursor[T OpenSearchDocumentAble] struct {
+	repo      *openSearchRepository[T]
+	scrollID  string
+	keepAlive time.Duration
+	first     []T
+	done      bool
+}
+
+// Scroll starts a scroll search, keeping it alive for keepAlive between
+// batches, and is the appropriate way to page through result sets beyond
+// index.max_result_window (the default 10k cap on from+size).
+func (r *openSearchRepository[T]) Scroll(indexName string, req *map[string]interface{}, keepAlive time.Duration) (ScrollCursor[T], error) {
+	if !r.IsAvailable() {
+		return nil, ErrClusterUnavailable
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		r.logger.Error(
+			err.Error(),
+			zap.Error(err),
+			zap.String("index_name", indexName),
+		)
+		return nil, err
+	}
+
+	var scrollID string
+	var docs []T
+
+	err = r.doWithRetry(func(ctx context.Context) (int, error) {
+		search := opensearchapi.SearchRequest{
+			Index:  []string{indexName},
+			Body:   bytes.NewReader(reqJSON),
+			Scroll: keepAlive,
+		}
+
+		res, err := search.Do(ctx, r.opensearchClient)
+		if err != nil {
+			r.logger.Error(
+				err.Error(),
+				zap.Error(err),
+				zap.String("index_name", indexName),
+			)
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		var raw map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+			return res.StatusCode, err
+		}
+
+		scrollID, _ = raw["_scroll_id"].(string)
+		hits, _ := raw["hits"].(map[string]interface{})
+		hitList, _ := hits["hits"].([]interface{})
+
+		decoded, err := decodeHits[T](hitList)
+		if err != nil {
+			return res.StatusCode, err
+		}
+		docs = decoded
+
+		return res.StatusCode, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &scrollCursor[T]{
+		repo:      r,
+		scrollID:  scrollID,
+		keepAlive: keepAlive,
+		first:     docs,
+	}, nil
+}
+
+func (c *scrollCursor[T]) Next(ctx context.Context) ([]T, error) {
+	if c.first != nil {
+		docs := c.first
+		c.first = nil
+		return docs, nil
+	}
+
+	if c.done {
+		return nil, nil
+	}
+
+	if !c.repo.IsAvailable() {
+		return nil, ErrClusterUnavailable
+	}
+
+	var docs []T
+	err := c.repo.doWithRetryCtx(ctx, func(ctx context.Context) (int, error) {
+		scroll := opensearchapi.ScrollRequest{
+			ScrollID: c.scrollID,
+			Scroll:   c.keepAlive,
+		}
+
+		res, err := scroll.Do(ctx, c.repo.opensearchClient)
+		if err != nil {
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		var raw map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+			return res.StatusCode, err
+		}
+
+		if scrollID, ok := raw["_scroll_id"].(string); ok {
+			c.scrollID = scrollID
+		}
+
+		hits, _ := raw["hits"].(map[string]interface{})
+		hitList, _ := hits["hits"].([]interface{})
+
+		decoded, err := decodeHits[T](hitList)
+		if err != nil {
+			return res.StatusCode, err
+		}
+		docs = decoded
+
+		if len(docs) == 0 {
+			c.done = true
+		}
+
+		return res.StatusCode, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// Close releases the scroll context via the Clear Scroll API, given the same
+// RequestTimeout/retry/IsAvailable treatment as the rest of this cursor.
+func (c *scrollCursor[T]) Close() error {
+	if c.scrollID == "" {
+		return nil
+	}
+
+	if !c.repo.IsAvailable() {
+		return ErrClusterUnavailable
+	}
+
+	return c.repo.doWithRetry(func(ctx context.Context) (int, error) {
+		clear := opensearchapi.ClearScrollRequest{
+			ScrollID: []string{c.scrollID},
+		}
+
+		res, err := clear.Do(ctx, c.repo.opensearchClient)
+		if err != nil {
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		return res.StatusCode, nil
+	})
+}
+
+type searchAfterCursor[T OpenSearchDocumentAble] struct {
+	repo        *openSearchRepository[T]
+	indexName   string
+	req         map[string]interface{}
+	sort        []interface{}
+	pageSize    int
+	searchAfter []interface{}
+	done        bool
+}
+
+// SearchAfter pages through req using the search_after pattern, which (unlike
+// Scroll) does not hold a point-in-time snapshot open on the cluster between
+// pages and is the recommended approach for deep, resumable pagination.
+func (r *openSearchRepository[T]) SearchAfter(indexName string, req *map[string]interface{}, sort []interface{}, pageSize int) (Cursor[T], error) {
+	if !r.IsAvailable() {
+		return nil, ErrClusterUnavailable
+	}
+
+	return &searchAfterCursor[T]{
+		repo:      r,
+		indexName: indexName,
+		req:       *req,
+		sort:      sort,
+		pageSize:  pageSize,
+	}, nil
+}
+
+func (c *searchAfterCursor[T]) Next(ctx context.Context) ([]T, error) {
+	if c.done {
+		return nil, nil
+	}
+
+	if !c.repo.IsAvailable() {
+		return nil, ErrClusterUnavailable
+	}
+
+	page := map[string]interface{}{}
+	for k, v := range c.req {
+		page[k] = v
+	}
+	page["size"] = c.pageSize
+	page["sort"] = c.sort
+	if c.searchAfter != nil {
+		page["search_after"] = c.searchAfter
+	}
+
+	reqJSON, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []T
+	err = c.repo.doWithRetryCtx(ctx, func(ctx context.Context) (int, error) {
+		search := opensearchapi.SearchRequest{
+			Index: []string{c.indexName},
+			Body:  bytes.NewReader(reqJSON),
+		}
+
+		res, err := search.Do(ctx, c.repo.opensearchClient)
+		if err != nil {
+			return 0, err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return res.StatusCode, errors.New(res.String())
+		}
+
+		var raw map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+			return res.StatusCode, err
+		}
+
+		hits, _ := raw["hits"].(map[string]interface{})
+		hitList, _ := hits["hits"].([]interface{})
+
+		decoded, err := decodeHits[T](hitList)
+		if err != nil {
+			return res.StatusCode, err
+		}
+		docs = decoded
+
+		if len(hitList) == 0 {
+			c.done = true
+			return res.StatusCode, nil
+		}
+
+		if lastHit, ok := hitList[len(hitList)-1].(map[string]interface{}); ok {
+			if sortValues, ok := lastHit["sort"].([]interface{}); ok {
+				c.searchAfter = sortValues
+			}
+		}
+
+		return res.StatusCode, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// Close is a no-op: search_after holds no server-side state to release.
+func (c *searchAfterCursor[T]) Close() error {
+	return nil
+}
@@ -0,0 +1,288 @@
+package opensearchsdk
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// FilterType is the kind of leaf clause a Filter translates to in the
+// OpenSearch bool query (term, terms, range or exists).
+type FilterType string
+
+const (
+	FilterTypeTerm   FilterType = "term"
+	FilterTypeTerms  FilterType = "terms"
+	FilterTypeRange  FilterType = "range"
+	FilterTypeExists FilterType = "exists"
+)
+
+// Filter is a single bool-query leaf clause. Which fields are read depends on
+// Type: Term uses Value, Terms uses Values, Range uses RangeArgs (e.g.
+// {"gte": 1, "lte": 10}), and Exists only needs Field.
+type Filter struct {
+	Type      FilterType
+	Field     string
+	Value     interface{}
+	Values    []interface{}
+	RangeArgs map[string]interface{}
+}
+
+func (f Filter) toClause() (map[string]interface{}, error) {
+	switch f.Type {
+	case FilterTypeTerm:
+		return map[string]interface{}{"term": map[string]interface{}{f.Field: f.Value}}, nil
+	case FilterTypeTerms:
+		return map[string]interface{}{"terms": map[string]interface{}{f.Field: f.Values}}, nil
+	case FilterTypeRange:
+		return map[string]interface{}{"range": map[string]interface{}{f.Field: f.RangeArgs}}, nil
+	case FilterTypeExists:
+		return map[string]interface{}{"exists": map[string]interface{}{"field": f.Field}}, nil
+	default:
+		return nil, errors.Errorf("opensearchsdk: invalid filter type %q for field %q", f.Type, f.Field)
+	}
+}
+
+// SortOrder is the direction of a SortClause.
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// SortClause orders results by a single field.
+type SortClause struct {
+	Field string
+	Order SortOrder
+}
+
+// HighlightOptions configures which fields are highlighted and with what tags.
+type HighlightOptions struct {
+	Fields   []string
+	PreTags  []string
+	PostTags []string
+}
+
+func (h *HighlightOptions) toClause() map[string]interface{} {
+	fields := map[string]interface{}{}
+	for _, field := range h.Fields {
+		fields[field] = map[string]interface{}{}
+	}
+
+	clause := map[string]interface{}{"fields": fields}
+	if len(h.PreTags) > 0 {
+		clause["pre_tags"] = h.PreTags
+	}
+	if len(h.PostTags) > 0 {
+		clause["post_tags"] = h.PostTags
+	}
+
+	return clause
+}
+
+// SearchOptions is a typed builder for the subset of the OpenSearch query DSL
+// used throughout this SDK: a keyword search over a set of fields, bool
+// filters, sorting, highlighting, source filtering and aggregations. Anything
+// it does not cover can still be expressed with SearchRaw.
+type SearchOptions struct {
+	Keyword string
+	Fields  []string
+
+	Must    []Filter
+	Should  []Filter
+	MustNot []Filter
+
+	Sort      []SortClause
+	Highlight *HighlightOptions
+
+	SourceIncludes []string
+	SourceExcludes []string
+
+	Aggregations map[string]interface{}
+}
+
+func (o *SearchOptions) toQuery() (map[string]interface{}, error) {
+	boolQuery := map[string]interface{}{}
+
+	must := make([]map[string]interface{}, 0, len(o.Must)+1)
+	if o.Keyword != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  o.Keyword,
+				"fields": o.Fields,
+			},
+		})
+	}
+	for _, f := range o.Must {
+		clause, err := f.toClause()
+		if err != nil {
+			return nil, err
+		}
+		must = append(must, clause)
+	}
+	if len(must) > 0 {
+		boolQuery["must"] = must
+	}
+
+	if len(o.Should) > 0 {
+		should := make([]map[string]interface{}, 0, len(o.Should))
+		for _, f := range o.Should {
+			clause, err := f.toClause()
+			if err != nil {
+				return nil, err
+			}
+			should = append(should, clause)
+		}
+		boolQuery["should"] = should
+	}
+
+	if len(o.MustNot) > 0 {
+		mustNot := make([]map[string]interface{}, 0, len(o.MustNot))
+		for _, f := range o.MustNot {
+			clause, err := f.toClause()
+			if err != nil {
+				return nil, err
+			}
+			mustNot = append(mustNot, clause)
+		}
+		boolQuery["must_not"] = mustNot
+	}
+
+	req := map[string]interface{}{}
+	if len(boolQuery) > 0 {
+		req["query"] = map[string]interface{}{"bool": boolQuery}
+	}
+
+	if len(o.Sort) > 0 {
+		sort := make([]map[string]interface{}, 0, len(o.Sort))
+		for _, s := range o.Sort {
+			sort = append(sort, map[string]interface{}{s.Field: map[string]interface{}{"order": s.Order}})
+		}
+		req["sort"] = sort
+	}
+
+	if o.Highlight != nil {
+		req["highlight"] = o.Highlight.toClause()
+	}
+
+	if len(o.SourceIncludes) > 0 || len(o.SourceExcludes) > 0 {
+		source := map[string]interface{}{}
+		if len(o.SourceIncludes) > 0 {
+			source["includes"] = o.SourceIncludes
+		}
+		if len(o.SourceExcludes) > 0 {
+			source["excludes"] = o.SourceExcludes
+		}
+		req["_source"] = source
+	}
+
+	if len(o.Aggregations) > 0 {
+		req["aggs"] = o.Aggregations
+	}
+
+	return req, nil
+}
+
+// SearchResult is the typed response of OpenSearchRepository.Search: hits
+// decoded into T, raw aggregation buckets (left as json.RawMessage since
+// their shape depends on the aggregation type) and any highlighted fragments
+// keyed by document and field.
+type SearchResult[T OpenSearchDocumentAble] struct {
+	Hits         []T
+	Aggregations map[string]json.RawMessage
+	Highlights   map[string]map[string][]string
+}
+
+func decodeSearchResult[T OpenSearchDocumentAble](raw map[string]interface{}, result *SearchResult[T]) error {
+	hits, _ := raw["hits"].(map[string]interface{})
+	hitList, _ := hits["hits"].([]interface{})
+
+	result.Hits = make([]T, 0, len(hitList))
+	result.Highlights = map[string]map[string][]string{}
+
+	for _, h := range hitList {
+		hit, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		sourceJSON, err := json.Marshal(hit["_source"])
+		if err != nil {
+			return err
+		}
+
+		var doc T
+		if err := json.Unmarshal(sourceJSON, &doc); err != nil {
+			return err
+		}
+		result.Hits = append(result.Hits, doc)
+
+		if id, ok := hit["_id"].(string); ok {
+			if highlight, ok := hit["highlight"].(map[string]interface{}); ok {
+				result.Highlights[id] = decodeHighlight(highlight)
+			}
+		}
+	}
+
+	if aggs, ok := raw["aggregations"].(map[string]interface{}); ok {
+		result.Aggregations = map[string]json.RawMessage{}
+		for name, agg := range aggs {
+			aggJSON, err := json.Marshal(agg)
+			if err != nil {
+				return err
+			}
+			result.Aggregations[name] = aggJSON
+		}
+	}
+
+	return nil
+}
+
+// decodeHits unmarshals the "_source" of each raw hit into T. It is shared by
+// Scroll and SearchAfter, which decode the same "hits.hits" shape but otherwise
+// return different envelopes; Search decodes its hits in decodeSearchResult.
+func decodeHits[T OpenSearchDocumentAble](hitList []interface{}) ([]T, error) {
+	docs := make([]T, 0, len(hitList))
+
+	for _, h := range hitList {
+		hit, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		sourceJSON, err := json.Marshal(hit["_source"])
+		if err != nil {
+			return nil, err
+		}
+
+		var doc T
+		if err := json.Unmarshal(sourceJSON, &doc); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func decodeHighlight(highlight map[string]interface{}) map[string][]string {
+	decoded := make(map[string][]string, len(highlight))
+
+	for field, fragments := range highlight {
+		list, ok := fragments.([]interface{})
+		if !ok {
+			continue
+		}
+
+		strs := make([]string, 0, len(list))
+		for _, fragment := range list {
+			if s, ok := fragment.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		decoded[field] = strs
+	}
+
+	return decoded
+}